@@ -17,6 +17,7 @@ type QueryOption struct {
 	UserID       string `json:"user_id,omitempty"`
 	Limit        int    `json:"limit,omitempty"`  // pagination option: limit number of results
 	Offset       int    `json:"offset,omitempty"` // pagination option: offset to return items from
+	Next         string `json:"next,omitempty"`   // pagination option: cursor returned by a previous page, mutually exclusive with Offset
 	MessageLimit *int   `json:"message_limit,omitempty"`
 	MemberLimit  *int   `json:"member_limit,omitempty"`
 }
@@ -34,6 +35,7 @@ type queryRequest struct {
 	UserID       string `json:"user_id,omitempty"`
 	Limit        int    `json:"limit,omitempty"`
 	Offset       int    `json:"offset,omitempty"`
+	Next         string `json:"next,omitempty"`
 	MemberLimit  *int   `json:"member_limit,omitempty"`
 	MessageLimit *int   `json:"message_limit,omitempty"`
 
@@ -41,17 +43,49 @@ type queryRequest struct {
 	Sort             []*SortOption          `json:"sort,omitempty"`
 }
 
-type queryUsersResponse struct {
-	Users []*User `json:"users"`
+// validate checks that Offset is not used together with Next or sorters,
+// mirroring the restriction SearchWithFullResponse applies to SearchRequest.
+// sorters must be the same slice the caller is about to send as the sort
+// parameter; q.Sort itself is not sent by any *WithFullResponse method.
+func (q *QueryOption) validate(sorters ...*SortOption) error {
+	if q.Offset != 0 && (q.Next != "" || len(sorters) > 0) {
+		return errors.New("cannot use Offset with Next or Sort parameters")
+	}
+	return nil
+}
+
+// QueryUsersResponse wraps the users returned by QueryUsersWithFullResponse
+// together with pagination cursors and the total number of matching users.
+type QueryUsersResponse struct {
+	Users      []*User `json:"users"`
+	Next       string  `json:"next,omitempty"`
+	Previous   string  `json:"previous,omitempty"`
+	TotalCount int64   `json:"total_count,omitempty"`
 }
 
 // QueryUsers returns list of users that match QueryOption.
 // If any number of SortOption are set, result will be sorted by field and direction in the order of sort options.
 func (c *Client) QueryUsers(ctx context.Context, q *QueryOption, sorters ...*SortOption) ([]*User, error) {
+	resp, err := c.QueryUsersWithFullResponse(ctx, q, sorters...)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Users, nil
+}
+
+// QueryUsersWithFullResponse returns users that match QueryOption along with pagination
+// cursors and the total number of matching users. If q.Next is set, it is used to fetch
+// the next page instead of q.Offset.
+func (c *Client) QueryUsersWithFullResponse(ctx context.Context, q *QueryOption, sorters ...*SortOption) (*QueryUsersResponse, error) {
+	if err := q.validate(sorters...); err != nil {
+		return nil, err
+	}
+
 	qp := queryRequest{
 		FilterConditions: q.Filter,
 		Limit:            q.Limit,
 		Offset:           q.Offset,
+		Next:             q.Next,
 		Sort:             sorters,
 	}
 
@@ -63,14 +97,19 @@ func (c *Client) QueryUsers(ctx context.Context, q *QueryOption, sorters ...*Sor
 	values := make(url.Values)
 	values.Set("payload", string(data))
 
-	var resp queryUsersResponse
-	err = c.makeRequest(ctx, http.MethodGet, "users", values, nil, &resp)
+	var resp QueryUsersResponse
+	if err := c.makeRequest(ctx, http.MethodGet, "users", values, nil, &resp); err != nil {
+		return nil, err
+	}
 
-	return resp.Users, err
+	return &resp, nil
 }
 
 type queryChannelResponse struct {
-	Channels []queryChannelResponseData `json:"channels"`
+	Channels   []queryChannelResponseData `json:"channels"`
+	Next       string                     `json:"next,omitempty"`
+	Previous   string                     `json:"previous,omitempty"`
+	TotalCount int64                      `json:"total_count,omitempty"`
 }
 
 type queryChannelResponseData struct {
@@ -80,9 +119,33 @@ type queryChannelResponseData struct {
 	Members  []*ChannelMember `json:"members"`
 }
 
+// QueryChannelsResponse wraps the channels returned by QueryChannelsWithFullResponse
+// together with pagination cursors and the total number of matching channels.
+type QueryChannelsResponse struct {
+	Channels   []*Channel `json:"channels"`
+	Next       string     `json:"next,omitempty"`
+	Previous   string     `json:"previous,omitempty"`
+	TotalCount int64      `json:"total_count,omitempty"`
+}
+
 // QueryChannels returns list of channels with members and messages, that match QueryOption.
 // If any number of SortOption are set, result will be sorted by field and direction in oder of sort options.
 func (c *Client) QueryChannels(ctx context.Context, q *QueryOption, sort ...*SortOption) ([]*Channel, error) {
+	resp, err := c.QueryChannelsWithFullResponse(ctx, q, sort...)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Channels, nil
+}
+
+// QueryChannelsWithFullResponse returns channels with members and messages that match
+// QueryOption, along with pagination cursors and the total number of matching channels.
+// If q.Next is set, it is used to fetch the next page instead of q.Offset.
+func (c *Client) QueryChannelsWithFullResponse(ctx context.Context, q *QueryOption, sort ...*SortOption) (*QueryChannelsResponse, error) {
+	if err := q.validate(sort...); err != nil {
+		return nil, err
+	}
+
 	qp := queryRequest{
 		State:            true,
 		FilterConditions: q.Filter,
@@ -90,6 +153,7 @@ func (c *Client) QueryChannels(ctx context.Context, q *QueryOption, sort ...*Sor
 		UserID:           q.UserID,
 		Limit:            q.Limit,
 		Offset:           q.Offset,
+		Next:             q.Next,
 		MemberLimit:      q.MemberLimit,
 		MessageLimit:     q.MessageLimit,
 	}
@@ -108,7 +172,12 @@ func (c *Client) QueryChannels(ctx context.Context, q *QueryOption, sort ...*Sor
 		result[i].client = c
 	}
 
-	return result, nil
+	return &QueryChannelsResponse{
+		Channels:   result,
+		Next:       resp.Next,
+		Previous:   resp.Previous,
+		TotalCount: resp.TotalCount,
+	}, nil
 }
 
 type SearchRequest struct {
@@ -117,6 +186,27 @@ type SearchRequest struct {
 	Filters        map[string]interface{} `json:"filter_conditions"`
 	MessageFilters map[string]interface{} `json:"message_filter_conditions"`
 
+	// Terms, optional. When set, it takes precedence over Query: SearchWithFullResponse
+	// issues one underlying search per term group (hashtag vs. plain text), merges the
+	// results deduplicating by message ID, and, when any result carries a score,
+	// re-sorts them before returning. Build it with ParseSearchTerms.
+	Terms []SearchTerm `json:"-"`
+
+	// Highlight, optional. Asks the server to return per-term highlight ranges for
+	// each matched message.
+	Highlight bool `json:"highlight,omitempty"`
+
+	// MinScore, optional. Drops results scoring below this relevance cutoff. Only
+	// valid when Sort is unset or sorts by "score"; SortDirection expands to a sort
+	// by "score", so it can be combined with MinScore freely.
+	MinScore float64 `json:"min_score,omitempty"`
+
+	// PreviousContext and NextContext, optional. Ask the server to return this many
+	// messages immediately before/after each hit, exposed on SearchMessageResponse as
+	// Previous/Next.
+	PreviousContext int `json:"previous_message_count,omitempty"`
+	NextContext     int `json:"next_message_count,omitempty"`
+
 	// Pagination, optional
 	Limit  int    `json:"limit,omitempty"`
 	Offset int    `json:"offset,omitempty"`
@@ -124,6 +214,10 @@ type SearchRequest struct {
 
 	// Sort, optional
 	Sort []SortOption `json:"sort,omitempty"`
+
+	// SortDirection, optional shortcut for Sort: "asc" or "desc", sorting by
+	// relevance score. Mutually exclusive with Sort.
+	SortDirection string `json:"-"`
 }
 
 type SearchResponse struct {
@@ -132,8 +226,27 @@ type SearchResponse struct {
 	Previous string                  `json:"previous,omitempty"`
 }
 
+// Range is a half-open [Start, End) offset range into a message's text, used to
+// report highlighted spans for a search term match.
+type Range struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
 type SearchMessageResponse struct {
 	Message *Message `json:"message"`
+
+	// Score is the relevance score assigned by the search backend.
+	Score float64 `json:"score,omitempty"`
+
+	// Highlights maps a matched search term to the Ranges in Message.Text it
+	// occurs at, present when the request set Highlight.
+	Highlights map[string][]Range `json:"highlights,omitempty"`
+
+	// Previous and Next are the surrounding messages requested via
+	// SearchRequest.PreviousContext/NextContext.
+	Previous []*Message `json:"previous_messages,omitempty"`
+	Next     []*Message `json:"next_messages,omitempty"`
 }
 
 // Search returns channels matching for given keyword.
@@ -150,8 +263,33 @@ func (c *Client) Search(ctx context.Context, request SearchRequest) ([]*Message,
 	return messages, nil
 }
 
-// SearchWithFullResponse performs a search and returns the full results.
+// searchScoreSortOption is the Sort value that SortDirection expands to, and the
+// only Sort value MinScore may be combined with.
+var searchScoreSortOption = SortOption{Field: "score"}
+
+// SearchWithFullResponse performs a search and returns the full results. If
+// request.Terms is set, it issues one underlying search per term group (hashtag
+// vs. plain text), merges the resulting messages deduplicating by message ID,
+// and, when any result carries a non-zero score, re-sorts them by score and
+// creation time before returning; otherwise the merged order is kept.
 func (c *Client) SearchWithFullResponse(ctx context.Context, request SearchRequest) (*SearchResponse, error) {
+	// SortDirection must be expanded into request.Sort before the Offset check
+	// below, so that Offset+SortDirection is rejected exactly like the
+	// equivalent Offset+Sort would be.
+	if request.SortDirection != "" {
+		if len(request.Sort) > 0 {
+			return nil, errors.New("cannot use SortDirection with Sort")
+		}
+		switch request.SortDirection {
+		case "asc":
+			request.Sort = []SortOption{{Field: searchScoreSortOption.Field, Direction: 1}}
+		case "desc":
+			request.Sort = []SortOption{{Field: searchScoreSortOption.Field, Direction: -1}}
+		default:
+			return nil, errors.New(`SortDirection must be "asc" or "desc"`)
+		}
+	}
+
 	if request.Offset != 0 {
 		if len(request.Sort) > 0 || request.Next != "" {
 			return nil, errors.New("cannot use Offset with Next or Sort parameters")
@@ -160,6 +298,53 @@ func (c *Client) SearchWithFullResponse(ctx context.Context, request SearchReque
 	if request.Query != "" && len(request.MessageFilters) != 0 {
 		return nil, errors.New("can only specify Query or MessageFilters, not both")
 	}
+
+	if request.MinScore != 0 {
+		if len(request.Sort) > 1 || (len(request.Sort) == 1 && request.Sort[0].Field != searchScoreSortOption.Field) {
+			return nil, errors.New(`MinScore can only be used when Sort is unset or sorts by "score"`)
+		}
+	}
+
+	groups := groupSearchTerms(request.Terms)
+
+	// Merging multiple groups produces a SearchResponse with no Next/Previous
+	// cursor, since there is no single underlying page it corresponds to.
+	// Reject pagination up front rather than silently returning unpaginable
+	// results.
+	if len(groups) > 1 && (request.Offset != 0 || request.Next != "") {
+		return nil, errors.New("cannot paginate a multi-group Terms search; narrow Terms to a single group (all-hashtag or all-plain) to paginate")
+	}
+
+	// A single group (e.g. an all-plain or all-hashtag query) needs no merge:
+	// route it straight through so the server's own relevance ordering and
+	// pagination cursors are preserved unchanged.
+	if len(groups) <= 1 {
+		single := request
+		single.Terms = nil
+		if len(groups) == 1 {
+			single.Query = renderSearchTerms(groups[0])
+		}
+		return c.doSearch(ctx, single)
+	}
+
+	var responses []*SearchResponse
+	for _, group := range groups {
+		groupRequest := request
+		groupRequest.Terms = nil
+		groupRequest.Query = renderSearchTerms(group)
+
+		resp, err := c.doSearch(ctx, groupRequest)
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, resp)
+	}
+
+	return mergeSearchResponses(responses), nil
+}
+
+// doSearch issues a single search request as-is, without any multi-term grouping.
+func (c *Client) doSearch(ctx context.Context, request SearchRequest) (*SearchResponse, error) {
 	var buf strings.Builder
 
 	if err := json.NewEncoder(&buf).Encode(request); err != nil {
@@ -176,16 +361,38 @@ func (c *Client) SearchWithFullResponse(ctx context.Context, request SearchReque
 	return &result, nil
 }
 
-type queryMessageFlagsResponse struct {
-	Flags []*MessageFlag `json:"flags"`
+// QueryMessageFlagsResponse wraps the message flags returned by
+// QueryMessageFlagsWithFullResponse together with pagination cursors and the total
+// number of matching flags.
+type QueryMessageFlagsResponse struct {
+	Flags      []*MessageFlag `json:"flags"`
+	Next       string         `json:"next,omitempty"`
+	Previous   string         `json:"previous,omitempty"`
+	TotalCount int64          `json:"total_count,omitempty"`
 }
 
 // QueryMessageFlags returns list of message flags that match QueryOption.
 func (c *Client) QueryMessageFlags(ctx context.Context, q *QueryOption) ([]*MessageFlag, error) {
+	resp, err := c.QueryMessageFlagsWithFullResponse(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Flags, nil
+}
+
+// QueryMessageFlagsWithFullResponse returns message flags that match QueryOption along
+// with pagination cursors and the total number of matching flags. If q.Next is set, it
+// is used to fetch the next page instead of q.Offset.
+func (c *Client) QueryMessageFlagsWithFullResponse(ctx context.Context, q *QueryOption) (*QueryMessageFlagsResponse, error) {
+	if err := q.validate(); err != nil {
+		return nil, err
+	}
+
 	qp := queryRequest{
 		FilterConditions: q.Filter,
 		Limit:            q.Limit,
 		Offset:           q.Offset,
+		Next:             q.Next,
 	}
 
 	data, err := json.Marshal(&qp)
@@ -196,8 +403,10 @@ func (c *Client) QueryMessageFlags(ctx context.Context, q *QueryOption) ([]*Mess
 	values := make(url.Values)
 	values.Set("payload", string(data))
 
-	var resp queryMessageFlagsResponse
-	err = c.makeRequest(ctx, http.MethodGet, "moderation/flags/message", values, nil, &resp)
+	var resp QueryMessageFlagsResponse
+	if err := c.makeRequest(ctx, http.MethodGet, "moderation/flags/message", values, nil, &resp); err != nil {
+		return nil, err
+	}
 
-	return resp.Flags, err
+	return &resp, nil
 }
@@ -0,0 +1,252 @@
+package stream_chat // nolint: golint
+
+import (
+	"context"
+	"io"
+)
+
+// defaultIterPageSize is the page size iterators use when PageSize is left unset.
+const defaultIterPageSize = 100
+
+// pageFunc fetches one page of results for an Iterator. limit is the page
+// size to request; offset and cursor are the two pagination mechanisms an
+// Iterator can use to ask for the next page, selected by useCursor. It
+// returns the page's items and the cursor token for the following page, if
+// the underlying endpoint returned one.
+type pageFunc[T any] func(ctx context.Context, limit, offset int, cursor string, useCursor bool) (items []T, next string, err error)
+
+// Iterator pages through results of type T, fetching additional pages on
+// demand as the caller consumes results via Next or Chan. It prefers the
+// cursor token returned by the underlying endpoint and falls back to Offset
+// when no cursor is returned. Requires go1.18+ for generics.
+type Iterator[T any] struct {
+	// PageSize controls how many items are fetched per underlying request. It
+	// defaults to defaultIterPageSize and should be set, if at all, before the
+	// first call to Next or Chan.
+	PageSize int
+
+	fetch pageFunc[T]
+
+	buf        []T
+	pos        int
+	offset     int
+	cursor     string
+	haveCursor bool
+	exhausted  bool
+	err        error
+}
+
+func newIterator[T any](fetch pageFunc[T]) *Iterator[T] {
+	return &Iterator[T]{PageSize: defaultIterPageSize, fetch: fetch}
+}
+
+func (it *Iterator[T]) fetchPage(ctx context.Context) error {
+	if it.PageSize <= 0 {
+		it.PageSize = defaultIterPageSize
+	}
+
+	usingCursor := it.haveCursor
+
+	items, next, err := it.fetch(ctx, it.PageSize, it.offset, it.cursor, usingCursor)
+	if err != nil {
+		return err
+	}
+
+	it.buf = items
+	it.pos = 0
+
+	switch {
+	case next != "":
+		it.haveCursor = true
+		it.cursor = next
+	case usingCursor:
+		// The endpoint was paging by cursor and stopped returning one: that's
+		// the terminal signal regardless of how many items this last page
+		// held. Falling back to Offset here would re-fetch rows already
+		// returned via the cursor.
+		it.haveCursor = false
+		it.exhausted = true
+	default:
+		it.haveCursor = false
+		it.offset += len(items)
+		if len(items) < it.PageSize {
+			it.exhausted = true
+		}
+	}
+
+	if len(items) == 0 {
+		it.exhausted = true
+	}
+
+	return nil
+}
+
+// Next returns the next item, fetching additional pages as needed. It
+// returns io.EOF once all matching items have been returned.
+func (it *Iterator[T]) Next(ctx context.Context) (T, error) {
+	var zero T
+	if it.err != nil {
+		return zero, it.err
+	}
+
+	for it.pos >= len(it.buf) {
+		if it.exhausted {
+			return zero, io.EOF
+		}
+		if err := it.fetchPage(ctx); err != nil {
+			it.err = err
+			return zero, err
+		}
+		if len(it.buf) == 0 {
+			return zero, io.EOF
+		}
+	}
+
+	v := it.buf[it.pos]
+	it.pos++
+	return v, nil
+}
+
+// Chan returns a channel of items that is closed once iteration is exhausted
+// or ctx is cancelled. Any error encountered is available via Err.
+func (it *Iterator[T]) Chan(ctx context.Context) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for {
+			v, err := it.Next(ctx)
+			if err != nil {
+				return
+			}
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Err returns the first error encountered during iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// ChannelIterator pages through the channels matching a QueryOption. See IterChannels.
+type ChannelIterator = Iterator[*Channel]
+
+// IterChannels returns a ChannelIterator that transparently pages through the
+// channels matching q, preferring the cursor token returned by the API and
+// falling back to Offset when no cursor is returned. QueryChannelsWithFullResponse
+// rejects Offset together with sorters, so when sorters are given and a full
+// page comes back without a cursor, the next fetch fails and iteration ends
+// with that error.
+func (c *Client) IterChannels(ctx context.Context, q *QueryOption, sorters ...*SortOption) *ChannelIterator {
+	return newIterator(func(ctx context.Context, limit, offset int, cursor string, useCursor bool) ([]*Channel, string, error) {
+		qq := *q
+		qq.Limit = limit
+		if useCursor {
+			qq.Next = cursor
+			qq.Offset = 0
+		} else {
+			qq.Next = ""
+			qq.Offset = offset
+		}
+
+		resp, err := c.QueryChannelsWithFullResponse(ctx, &qq, sorters...)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Channels, resp.Next, nil
+	})
+}
+
+// UserIterator pages through the users matching a QueryOption. See IterUsers.
+type UserIterator = Iterator[*User]
+
+// IterUsers returns a UserIterator that transparently pages through the users
+// matching q, preferring the cursor token returned by the API and falling
+// back to Offset when no cursor is returned. QueryUsersWithFullResponse
+// rejects Offset together with sorters, so when sorters are given and a full
+// page comes back without a cursor, the next fetch fails and iteration ends
+// with that error.
+func (c *Client) IterUsers(ctx context.Context, q *QueryOption, sorters ...*SortOption) *UserIterator {
+	return newIterator(func(ctx context.Context, limit, offset int, cursor string, useCursor bool) ([]*User, string, error) {
+		qq := *q
+		qq.Limit = limit
+		if useCursor {
+			qq.Next = cursor
+			qq.Offset = 0
+		} else {
+			qq.Next = ""
+			qq.Offset = offset
+		}
+
+		resp, err := c.QueryUsersWithFullResponse(ctx, &qq, sorters...)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Users, resp.Next, nil
+	})
+}
+
+// MessageFlagIterator pages through the message flags matching a QueryOption. See IterMessageFlags.
+type MessageFlagIterator = Iterator[*MessageFlag]
+
+// IterMessageFlags returns a MessageFlagIterator that transparently pages
+// through the message flags matching q, preferring the cursor token returned
+// by the API and falling back to Offset when no cursor is returned.
+func (c *Client) IterMessageFlags(ctx context.Context, q *QueryOption) *MessageFlagIterator {
+	return newIterator(func(ctx context.Context, limit, offset int, cursor string, useCursor bool) ([]*MessageFlag, string, error) {
+		qq := *q
+		qq.Limit = limit
+		if useCursor {
+			qq.Next = cursor
+			qq.Offset = 0
+		} else {
+			qq.Next = ""
+			qq.Offset = offset
+		}
+
+		resp, err := c.QueryMessageFlagsWithFullResponse(ctx, &qq)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Flags, resp.Next, nil
+	})
+}
+
+// SearchIterator pages through the messages matching a SearchRequest. See IterSearch.
+type SearchIterator = Iterator[*Message]
+
+// IterSearch returns a SearchIterator that transparently pages through the
+// messages matching request, preferring the cursor token returned by the API
+// and falling back to Offset when no cursor is returned. SearchWithFullResponse
+// rejects Offset together with Sort or SortDirection, and for a Terms search
+// spanning more than one group, so in those cases a full page without a
+// cursor makes the next fetch fail and iteration ends with that error.
+func (c *Client) IterSearch(ctx context.Context, request SearchRequest) *SearchIterator {
+	return newIterator(func(ctx context.Context, limit, offset int, cursor string, useCursor bool) ([]*Message, string, error) {
+		req := request
+		req.Limit = limit
+		if useCursor {
+			req.Next = cursor
+			req.Offset = 0
+		} else {
+			req.Next = ""
+			req.Offset = offset
+		}
+
+		resp, err := c.SearchWithFullResponse(ctx, req)
+		if err != nil {
+			return nil, "", err
+		}
+
+		messages := make([]*Message, len(resp.Results))
+		for i, r := range resp.Results {
+			messages[i] = r.Message
+		}
+		return messages, resp.Next, nil
+	})
+}
@@ -0,0 +1,102 @@
+package stream_chat
+
+import (
+	"context"
+	"io"
+	"testing"
+)
+
+// TestIteratorStopsWhenCursorExhaustsOnAFullPage guards against falling back
+// to Offset mid-stream: if the endpoint was paging by cursor and returns a
+// full page with no next cursor, iteration must stop there instead of
+// re-fetching by Offset and yielding duplicates.
+func TestIteratorStopsWhenCursorExhaustsOnAFullPage(t *testing.T) {
+	ctx := context.Background()
+	pageSize := 2
+
+	calls := 0
+	it := newIterator(func(ctx context.Context, limit, offset int, cursor string, useCursor bool) ([]int, string, error) {
+		calls++
+		switch calls {
+		case 1:
+			// First page: cursor-paginated, full page, more to come.
+			return []int{1, 2}, "cursor-2", nil
+		case 2:
+			// Second page: still cursor-paginated, full page, but no next
+			// cursor. This must be treated as terminal.
+			if !useCursor || cursor != "cursor-2" {
+				t.Fatalf("call 2: expected cursor mode with cursor-2, got useCursor=%v cursor=%q", useCursor, cursor)
+			}
+			return []int{3, 4}, "", nil
+		default:
+			t.Fatalf("unexpected call %d: iterator should have stopped after the exhausted cursor page", calls)
+			return nil, "", nil
+		}
+	})
+	it.PageSize = pageSize
+
+	var got []int
+	for {
+		v, err := it.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Next() error = %v", err)
+		}
+		got = append(got, v)
+	}
+
+	want := []int{1, 2, 3, 4}
+	if len(got) != len(want) {
+		t.Fatalf("Next() yielded %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Next() yielded %v, want %v", got, want)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("fetch was called %d times, want exactly 2 (no offset fallback re-fetch)", calls)
+	}
+}
+
+// TestIteratorFallsBackToOffsetWhenNoCursorIsEverReturned covers the plain
+// Offset-paging path for an endpoint that never returns a cursor.
+func TestIteratorFallsBackToOffsetWhenNoCursorIsEverReturned(t *testing.T) {
+	ctx := context.Background()
+	data := [][]int{{1, 2}, {3, 4}, {5}}
+
+	calls := 0
+	it := newIterator(func(ctx context.Context, limit, offset int, cursor string, useCursor bool) ([]int, string, error) {
+		if useCursor {
+			t.Fatalf("call %d: expected offset mode, got cursor mode", calls+1)
+		}
+		if offset != calls*2 {
+			t.Fatalf("call %d: offset = %d, want %d", calls+1, offset, calls*2)
+		}
+		page := data[calls]
+		calls++
+		return page, "", nil
+	})
+	it.PageSize = 2
+
+	var got []int
+	for {
+		v, err := it.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Next() error = %v", err)
+		}
+		got = append(got, v)
+	}
+
+	if len(got) != 5 {
+		t.Fatalf("Next() yielded %v, want 5 items", got)
+	}
+	if calls != 3 {
+		t.Errorf("fetch was called %d times, want 3", calls)
+	}
+}
@@ -0,0 +1,112 @@
+package stream_chat // nolint: golint
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// defaultMultiQueryConcurrency is the MaxConcurrency used by QueryChannelsMulti
+// when no WithMaxConcurrency option is given.
+const defaultMultiQueryConcurrency = 4
+
+type multiQueryConfig struct {
+	maxConcurrency int
+	dedup          bool
+}
+
+// MultiQueryOption configures the behavior of QueryChannelsMulti.
+type MultiQueryOption func(*multiQueryConfig)
+
+// WithMaxConcurrency bounds how many QueryChannels calls QueryChannelsMulti
+// runs at once. It defaults to defaultMultiQueryConcurrency.
+func WithMaxConcurrency(n int) MultiQueryOption {
+	return func(cfg *multiQueryConfig) {
+		cfg.maxConcurrency = n
+	}
+}
+
+// WithDedup makes QueryChannelsMulti drop channels that appear in more than one
+// result set, keeping only the first occurrence by CID.
+func WithDedup() MultiQueryOption {
+	return func(cfg *multiQueryConfig) {
+		cfg.dedup = true
+	}
+}
+
+// QueryChannelsMulti runs a QueryChannels call for each entry in queries
+// concurrently, bounded by MaxConcurrency (default defaultMultiQueryConcurrency),
+// and returns the results in the same order as queries. Each query's own
+// q.Sort is passed through to its QueryChannels call, so buckets can be
+// sorted independently. If WithDedup is given, channels that appear in more
+// than one result set are dropped, keeping the first occurrence by CID. ctx
+// is passed through to every underlying call, so a caller-supplied deadline
+// or cancellation bounds the whole batch.
+func (c *Client) QueryChannelsMulti(ctx context.Context, queries []*QueryOption, opts ...MultiQueryOption) ([][]*Channel, error) {
+	cfg := multiQueryConfig{maxConcurrency: defaultMultiQueryConcurrency}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.maxConcurrency <= 0 {
+		cfg.maxConcurrency = defaultMultiQueryConcurrency
+	}
+
+	results := make([][]*Channel, len(queries))
+	errs := make([]error, len(queries))
+	sem := make(chan struct{}, cfg.maxConcurrency)
+
+	var wg sync.WaitGroup
+	for i, q := range queries {
+		wg.Add(1)
+		go func(i int, q *QueryOption) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+			defer func() { <-sem }()
+
+			channels, err := c.QueryChannels(ctx, q, q.Sort...)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			results[i] = channels
+		}(i, q)
+	}
+	wg.Wait()
+
+	// errors.Join requires go1.20+; this module's go.mod must target at least
+	// that version.
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	if cfg.dedup {
+		results = dedupChannelResults(results)
+	}
+
+	return results, nil
+}
+
+// dedupChannelResults drops channels that appear in more than one bucket,
+// keeping only the first occurrence by CID, in bucket order.
+func dedupChannelResults(buckets [][]*Channel) [][]*Channel {
+	seen := make(map[string]bool)
+	out := make([][]*Channel, len(buckets))
+	for i, bucket := range buckets {
+		filtered := make([]*Channel, 0, len(bucket))
+		for _, ch := range bucket {
+			if ch == nil || seen[ch.CID] {
+				continue
+			}
+			seen[ch.CID] = true
+			filtered = append(filtered, ch)
+		}
+		out[i] = filtered
+	}
+	return out
+}
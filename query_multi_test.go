@@ -0,0 +1,38 @@
+package stream_chat
+
+import "testing"
+
+func TestDedupChannelResultsKeepsFirstOccurrenceByCID(t *testing.T) {
+	chA := &Channel{CID: "messaging:a"}
+	chB := &Channel{CID: "messaging:b"}
+	chADup := &Channel{CID: "messaging:a"}
+
+	buckets := [][]*Channel{
+		{chA, chB},
+		{chADup, chB},
+	}
+
+	got := dedupChannelResults(buckets)
+
+	if len(got) != 2 {
+		t.Fatalf("dedupChannelResults() returned %d buckets, want 2", len(got))
+	}
+	if len(got[0]) != 2 || got[0][0] != chA || got[0][1] != chB {
+		t.Errorf("dedupChannelResults()[0] = %v, want first occurrences [chA, chB]", got[0])
+	}
+	if len(got[1]) != 0 {
+		t.Errorf("dedupChannelResults()[1] = %v, want empty (both channels already seen in bucket 0)", got[1])
+	}
+}
+
+func TestDedupChannelResultsSkipsNilChannels(t *testing.T) {
+	buckets := [][]*Channel{
+		{nil, &Channel{CID: "messaging:a"}},
+	}
+
+	got := dedupChannelResults(buckets)
+
+	if len(got[0]) != 1 || got[0][0].CID != "messaging:a" {
+		t.Errorf("dedupChannelResults() = %v, want nil entries dropped", got[0])
+	}
+}
@@ -0,0 +1,163 @@
+package stream_chat // nolint: golint
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// SearchTerm represents a single term parsed out of a raw search query by
+// ParseSearchTerms, such as a plain word, a quoted phrase, or a hashtag.
+type SearchTerm struct {
+	Text      string
+	IsHashtag bool
+	IsPhrase  bool
+	Exclude   bool
+}
+
+// ParseSearchTerms splits raw on whitespace into SearchTerms, honoring quoted
+// phrases ("exact phrase") and separating hashtag tokens (#topic) from plain
+// text. A leading "-" on a token or phrase marks it as excluded, for example
+// "#topic \"exact phrase\" -spam".
+func ParseSearchTerms(raw string) []SearchTerm {
+	var terms []SearchTerm
+
+	runes := []rune(raw)
+	n := len(runes)
+	for i := 0; i < n; {
+		for i < n && unicode.IsSpace(runes[i]) {
+			i++
+		}
+		if i >= n {
+			break
+		}
+
+		exclude := false
+		if runes[i] == '-' {
+			exclude = true
+			i++
+		}
+		if i >= n {
+			break
+		}
+
+		if runes[i] == '"' {
+			i++
+			start := i
+			for i < n && runes[i] != '"' {
+				i++
+			}
+			if text := string(runes[start:i]); text != "" {
+				terms = append(terms, SearchTerm{Text: text, IsPhrase: true, Exclude: exclude})
+			}
+			if i < n {
+				i++ // skip closing quote
+			}
+			continue
+		}
+
+		isHashtag := false
+		if runes[i] == '#' {
+			isHashtag = true
+			i++
+		}
+
+		start := i
+		for i < n && !unicode.IsSpace(runes[i]) {
+			i++
+		}
+		if text := string(runes[start:i]); text != "" {
+			terms = append(terms, SearchTerm{Text: text, IsHashtag: isHashtag, Exclude: exclude})
+		}
+	}
+
+	return terms
+}
+
+// groupSearchTerms splits terms into a plain-text group and a hashtag group,
+// preserving relative order and dropping empty groups. Exclude terms are
+// negations rather than their own query type, so they are appended to every
+// group instead of forming a group of their own — "#topic -spam" must exclude
+// spam from the hashtag search too, not just from a degenerate plain-text one.
+func groupSearchTerms(terms []SearchTerm) [][]SearchTerm {
+	var plain, hashtags, excludes []SearchTerm
+	for _, t := range terms {
+		switch {
+		case t.Exclude:
+			excludes = append(excludes, t)
+		case t.IsHashtag:
+			hashtags = append(hashtags, t)
+		default:
+			plain = append(plain, t)
+		}
+	}
+
+	var groups [][]SearchTerm
+	if len(plain) > 0 {
+		groups = append(groups, append(append([]SearchTerm{}, plain...), excludes...))
+	}
+	if len(hashtags) > 0 {
+		groups = append(groups, append(append([]SearchTerm{}, hashtags...), excludes...))
+	}
+	if len(groups) == 0 && len(excludes) > 0 {
+		groups = append(groups, excludes)
+	}
+	return groups
+}
+
+// renderSearchTerms reconstructs a query string from terms, suitable for the
+// Query field of a single underlying search request.
+func renderSearchTerms(terms []SearchTerm) string {
+	parts := make([]string, 0, len(terms))
+	for _, t := range terms {
+		s := t.Text
+		if t.IsHashtag {
+			s = "#" + s
+		}
+		if t.IsPhrase {
+			s = `"` + s + `"`
+		}
+		if t.Exclude {
+			s = "-" + s
+		}
+		parts = append(parts, s)
+	}
+	return strings.Join(parts, " ")
+}
+
+// mergeSearchResponses merges several SearchResponses into one, deduplicating
+// messages by ID. The merged results are only re-sorted when at least one
+// result actually carries a non-zero score — otherwise the backend isn't
+// returning relevance scores, and imposing an unrelated order would silently
+// override whatever order it did return. When sorted, ties in Score break on
+// the message's creation time, newest first.
+func mergeSearchResponses(responses []*SearchResponse) *SearchResponse {
+	merged := &SearchResponse{}
+
+	seen := make(map[string]bool)
+	hasScore := false
+	for _, resp := range responses {
+		for _, res := range resp.Results {
+			if res.Message == nil || seen[res.Message.ID] {
+				continue
+			}
+			seen[res.Message.ID] = true
+			if res.Score != 0 {
+				hasScore = true
+			}
+			merged.Results = append(merged.Results, res)
+		}
+	}
+
+	if hasScore {
+		sort.SliceStable(merged.Results, func(i, j int) bool {
+			a, b := merged.Results[i], merged.Results[j]
+			if a.Score != b.Score {
+				return a.Score > b.Score
+			}
+			return a.Message.CreatedAt.After(b.Message.CreatedAt)
+		})
+	}
+
+	return merged
+}
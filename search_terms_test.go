@@ -0,0 +1,206 @@
+package stream_chat
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseSearchTerms(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want []SearchTerm
+	}{
+		{
+			name: "plain words",
+			raw:  "hello world",
+			want: []SearchTerm{
+				{Text: "hello"},
+				{Text: "world"},
+			},
+		},
+		{
+			name: "hashtag",
+			raw:  "#topic",
+			want: []SearchTerm{
+				{Text: "topic", IsHashtag: true},
+			},
+		},
+		{
+			name: "quoted phrase",
+			raw:  `"exact phrase"`,
+			want: []SearchTerm{
+				{Text: "exact phrase", IsPhrase: true},
+			},
+		},
+		{
+			name: "excluded plain word",
+			raw:  "-spam",
+			want: []SearchTerm{
+				{Text: "spam", Exclude: true},
+			},
+		},
+		{
+			name: "excluded hashtag",
+			raw:  "-#spam",
+			want: []SearchTerm{
+				{Text: "spam", IsHashtag: true, Exclude: true},
+			},
+		},
+		{
+			name: "excluded phrase",
+			raw:  `-"exact phrase"`,
+			want: []SearchTerm{
+				{Text: "exact phrase", IsPhrase: true, Exclude: true},
+			},
+		},
+		{
+			name: "mixed query",
+			raw:  `from:@user #topic "exact phrase" -spam`,
+			want: []SearchTerm{
+				{Text: "from:@user"},
+				{Text: "topic", IsHashtag: true},
+				{Text: "exact phrase", IsPhrase: true},
+				{Text: "spam", Exclude: true},
+			},
+		},
+		{
+			name: "unterminated quote is read to end of input",
+			raw:  `"never closed`,
+			want: []SearchTerm{
+				{Text: "never closed", IsPhrase: true},
+			},
+		},
+		{
+			name: "bare hashtag marker is dropped",
+			raw:  "# word",
+			want: []SearchTerm{
+				{Text: "word"},
+			},
+		},
+		{
+			name: "bare exclude marker is dropped",
+			raw:  "- word",
+			want: []SearchTerm{
+				{Text: "word"},
+			},
+		},
+		{
+			name: "empty input",
+			raw:  "   ",
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseSearchTerms(tt.raw)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseSearchTerms(%q) = %#v, want %#v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroupSearchTermsCarriesExcludesIntoEveryGroup(t *testing.T) {
+	terms := ParseSearchTerms("#topic -spam")
+
+	groups := groupSearchTerms(terms)
+	if len(groups) != 1 {
+		t.Fatalf("groupSearchTerms() = %d groups, want 1 (hashtag only)", len(groups))
+	}
+
+	got := renderSearchTerms(groups[0])
+	want := "#topic -spam"
+	if got != want {
+		t.Errorf("renderSearchTerms(groups[0]) = %q, want %q", got, want)
+	}
+}
+
+func TestGroupSearchTermsCarriesExcludesIntoBothPlainAndHashtagGroups(t *testing.T) {
+	terms := ParseSearchTerms("hello #topic -spam")
+
+	groups := groupSearchTerms(terms)
+	if len(groups) != 2 {
+		t.Fatalf("groupSearchTerms() = %d groups, want 2 (plain and hashtag)", len(groups))
+	}
+
+	for _, group := range groups {
+		rendered := renderSearchTerms(group)
+		if !containsExclude(group) {
+			t.Errorf("group %q does not carry the exclude term", rendered)
+		}
+	}
+}
+
+func containsExclude(terms []SearchTerm) bool {
+	for _, t := range terms {
+		if t.Exclude {
+			return true
+		}
+	}
+	return false
+}
+
+func TestMergeSearchResponsesDedupesByMessageID(t *testing.T) {
+	shared := &Message{ID: "msg-1"}
+	responses := []*SearchResponse{
+		{Results: []SearchMessageResponse{{Message: shared}, {Message: &Message{ID: "msg-2"}}}},
+		{Results: []SearchMessageResponse{{Message: shared}, {Message: &Message{ID: "msg-3"}}}},
+	}
+
+	merged := mergeSearchResponses(responses)
+	if len(merged.Results) != 3 {
+		t.Fatalf("mergeSearchResponses() returned %d results, want 3", len(merged.Results))
+	}
+
+	seen := make(map[string]int)
+	for _, res := range merged.Results {
+		seen[res.Message.ID]++
+	}
+	for id, count := range seen {
+		if count != 1 {
+			t.Errorf("message %q appears %d times in merged results, want 1", id, count)
+		}
+	}
+}
+
+func TestMergeSearchResponsesOnlySortsByScoreWhenScored(t *testing.T) {
+	unscored := []*SearchResponse{
+		{Results: []SearchMessageResponse{{Message: &Message{ID: "a"}}, {Message: &Message{ID: "b"}}}},
+	}
+	merged := mergeSearchResponses(unscored)
+	if merged.Results[0].Message.ID != "a" || merged.Results[1].Message.ID != "b" {
+		t.Errorf("mergeSearchResponses() reordered unscored results, want original order preserved")
+	}
+
+	scored := []*SearchResponse{
+		{Results: []SearchMessageResponse{
+			{Message: &Message{ID: "low"}, Score: 0.1},
+			{Message: &Message{ID: "high"}, Score: 0.9},
+		}},
+	}
+	merged = mergeSearchResponses(scored)
+	if merged.Results[0].Message.ID != "high" || merged.Results[1].Message.ID != "low" {
+		t.Errorf("mergeSearchResponses() = %v, want highest score first", merged.Results)
+	}
+}
+
+func TestMergeSearchResponsesBreaksScoreTiesByCreatedAt(t *testing.T) {
+	now := time.Date(2026, 7, 29, 0, 0, 0, 0, time.UTC)
+	older := &Message{ID: "older", CreatedAt: now.Add(-time.Hour)}
+	newer := &Message{ID: "newer", CreatedAt: now}
+
+	responses := []*SearchResponse{
+		{Results: []SearchMessageResponse{
+			{Message: older, Score: 0.5},
+			{Message: newer, Score: 0.5},
+		}},
+	}
+
+	merged := mergeSearchResponses(responses)
+	if merged.Results[0].Message.ID != "newer" || merged.Results[1].Message.ID != "older" {
+		t.Errorf("mergeSearchResponses() = %v, want newest first on a Score tie", merged.Results)
+	}
+}